@@ -0,0 +1,89 @@
+package auth_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gorm/auth"
+)
+
+func TestTokenIssuerIssueAndParse(t *testing.T) {
+	issuer := auth.NewTokenIssuer("test-secret", time.Hour)
+
+	token, err := issuer.Issue(42, "admin")
+	require.NoError(t, err)
+
+	claims, err := issuer.Parse(token)
+	require.NoError(t, err)
+	require.Equal(t, uint(42), claims.UserID)
+	require.Equal(t, "admin", claims.Role)
+}
+
+func TestTokenIssuerParseExpired(t *testing.T) {
+	issuer := auth.NewTokenIssuer("test-secret", -time.Hour)
+
+	token, err := issuer.Issue(1, "user")
+	require.NoError(t, err)
+
+	_, err = issuer.Parse(token)
+	require.Error(t, err)
+}
+
+func TestTokenIssuerParseWrongSecret(t *testing.T) {
+	issuer := auth.NewTokenIssuer("test-secret", time.Hour)
+	other := auth.NewTokenIssuer("other-secret", time.Hour)
+
+	token, err := issuer.Issue(1, "user")
+	require.NoError(t, err)
+
+	_, err = other.Parse(token)
+	require.Error(t, err)
+}
+
+func TestMiddlewareMissingHeader(t *testing.T) {
+	issuer := auth.NewTokenIssuer("test-secret", time.Hour)
+	handler := issuer.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("next handler should not be reached")
+	}))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestMiddlewareValidToken(t *testing.T) {
+	issuer := auth.NewTokenIssuer("test-secret", time.Hour)
+	token, err := issuer.Issue(7, "admin")
+	require.NoError(t, err)
+
+	var gotID uint
+	var gotRole string
+	handler := issuer.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotID, _ = auth.UserIDFromContext(r.Context())
+		gotRole, _ = auth.RoleFromContext(r.Context())
+	}))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, uint(7), gotID)
+	require.Equal(t, "admin", gotRole)
+}
+
+func TestHashAndCheckPassword(t *testing.T) {
+	hash, err := auth.HashPassword("s3cret")
+	require.NoError(t, err)
+	require.NoError(t, auth.CheckPassword(hash, "s3cret"))
+	require.Error(t, auth.CheckPassword(hash, "wrong"))
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"errors"
+
+	"github.com/go-fuego/fuego"
+	"gorm.io/gorm"
+
+	"gorm/models"
+)
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type LoginResponse struct {
+	Token string `json:"token"`
+}
+
+// UserAuthenticator is the lookup the login handler needs; it is satisfied
+// by *queries.UserQueries.
+type UserAuthenticator interface {
+	GetUserByEmail(email string) (*models.User, error)
+}
+
+// NewLoginHandler returns a fuego controller that authenticates a user by
+// email/password and, on success, issues a JWT via issuer.
+func NewLoginHandler(issuer *TokenIssuer, users UserAuthenticator) func(fuego.ContextWithBody[LoginRequest]) (LoginResponse, error) {
+	return func(c fuego.ContextWithBody[LoginRequest]) (LoginResponse, error) {
+		body, err := c.Body()
+		if err != nil {
+			return LoginResponse{}, fuego.BadRequestError{Err: err}
+		}
+
+		user, err := users.GetUserByEmail(body.Email)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return LoginResponse{}, fuego.UnauthorizedError{Err: errors.New("invalid email or password")}
+			}
+			return LoginResponse{}, fuego.InternalServerError{Err: err}
+		}
+
+		if err := CheckPassword(user.PasswordHash, body.Password); err != nil {
+			return LoginResponse{}, fuego.UnauthorizedError{Err: errors.New("invalid email or password")}
+		}
+
+		token, err := issuer.Issue(user.ID, user.Role)
+		if err != nil {
+			return LoginResponse{}, fuego.InternalServerError{Err: err}
+		}
+		return LoginResponse{Token: token}, nil
+	}
+}
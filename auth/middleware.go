@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"net/http"
+	"strconv"
+
+	"gorm/models"
+)
+
+// RequireRole builds middleware that rejects requests (403) unless the
+// authenticated caller's role is one of the given roles. It assumes
+// TokenIssuer.Middleware has already run and populated the request context.
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, ok := RoleFromContext(r.Context())
+			if !ok {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+			for _, allowed := range roles {
+				if role == allowed {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			http.Error(w, "forbidden", http.StatusForbidden)
+		})
+	}
+}
+
+// RequireSelfOrAdmin allows the request through when the caller is an admin
+// or the {id} path value matches the caller's own user ID, and rejects
+// (403) otherwise.
+func RequireSelfOrAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if role, ok := RoleFromContext(r.Context()); ok && role == models.RoleAdmin {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		userID, ok := UserIDFromContext(r.Context())
+		if !ok {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		targetID, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil || uint(targetID) != userID {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
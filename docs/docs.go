@@ -0,0 +1,9 @@
+// Package docs embeds the static assets for the API's browsable
+// documentation (a small self-contained API explorer), served by main.go
+// under /docs so it works without fetching anything from a CDN.
+package docs
+
+import "embed"
+
+//go:embed static
+var FS embed.FS
@@ -0,0 +1,184 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"gorm/auth"
+	"gorm/handlers"
+	"gorm/models"
+	"gorm/queries/mocks"
+)
+
+// setupAuthServer wires main.go's GET /users and GET/PUT/DELETE /users/{id}
+// routes with the same authentication and authorization middleware, so tests
+// can exercise the full 401/403/self-vs-admin decision chain.
+func setupAuthServer(issuer *auth.TokenIssuer, mockUQ *mocks.UserQueries) *fuego.Server {
+	h := &handlers.Handlers{UserQueries: mockUQ}
+
+	s := fuego.NewServer(fuego.WithoutStartupMessages())
+
+	requireAuth := issuer.Middleware
+	requireAdmin := auth.RequireRole(models.RoleAdmin)
+	requireSelfOrAdmin := auth.RequireSelfOrAdmin
+
+	fuego.Get(s, "/users", h.GetUsers, fuego.OptionMiddleware(requireAuth, requireAdmin))
+	fuego.Get(s, "/users/{id}", h.GetUserByID, fuego.OptionMiddleware(requireAuth, requireSelfOrAdmin))
+	fuego.Put(s, "/users/{id}", h.UpdateUser, fuego.OptionMiddleware(requireAuth, requireSelfOrAdmin))
+	fuego.Delete(s, "/users/{id}", h.DeleteUser, fuego.OptionMiddleware(requireAuth, requireAdmin))
+
+	return s
+}
+
+func TestAuthorization(t *testing.T) {
+	issuer := auth.NewTokenIssuer("test-secret", time.Hour)
+	users := map[uint]*models.User{
+		1: {Model: gorm.Model{ID: 1}, Name: "Admin", Email: "admin@example.com", Role: models.RoleAdmin},
+		2: {Model: gorm.Model{ID: 2}, Name: "Alice", Email: "alice@example.com", Role: models.RoleUser},
+		3: {Model: gorm.Model{ID: 3}, Name: "Bob", Email: "bob@example.com", Role: models.RoleUser},
+	}
+
+	mockUQ := mocks.NewUserQueries(t)
+	onUsersPage(mockUQ, users)
+	for id, u := range users {
+		mockUQ.On("GetUserByID", id).Return(u, nil).Maybe()
+	}
+	s := setupAuthServer(issuer, mockUQ)
+
+	adminToken, err := issuer.Issue(1, models.RoleAdmin)
+	require.NoError(t, err)
+	aliceToken, err := issuer.Issue(2, models.RoleUser)
+	require.NoError(t, err)
+	bobToken, err := issuer.Issue(3, models.RoleUser)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		token      string
+		wantStatus int
+	}{
+		{
+			name:       "no token on admin-only route",
+			method:     http.MethodGet,
+			path:       "/users",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "non-admin forbidden from listing users",
+			method:     http.MethodGet,
+			path:       "/users",
+			token:      aliceToken,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "admin can list users",
+			method:     http.MethodGet,
+			path:       "/users",
+			token:      adminToken,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "no token on self-or-admin route",
+			method:     http.MethodGet,
+			path:       "/users/2",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "self can read own record",
+			method:     http.MethodGet,
+			path:       "/users/2",
+			token:      aliceToken,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "non-self non-admin forbidden",
+			method:     http.MethodGet,
+			path:       "/users/3",
+			token:      aliceToken,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "admin can read any record",
+			method:     http.MethodGet,
+			path:       "/users/3",
+			token:      adminToken,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "non-admin forbidden from delete",
+			method:     http.MethodDelete,
+			path:       "/users/3",
+			token:      bobToken,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			s.Mux.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestLoginHandler(t *testing.T) {
+	issuer := auth.NewTokenIssuer("test-secret", time.Hour)
+	hash, err := auth.HashPassword("correct-horse")
+	require.NoError(t, err)
+
+	mockUQ := mocks.NewUserQueries(t)
+	mockUQ.On("GetUserByEmail", "admin@example.com").Return(&models.User{
+		Model:        gorm.Model{ID: 1},
+		Email:        "admin@example.com",
+		Role:         models.RoleAdmin,
+		PasswordHash: hash,
+	}, nil)
+
+	s := fuego.NewServer(fuego.WithoutStartupMessages())
+	fuego.Post(s, "/login", auth.NewLoginHandler(issuer, mockUQ))
+
+	tests := []struct {
+		name       string
+		payload    string
+		wantStatus int
+	}{
+		{
+			name:       "correct credentials",
+			payload:    `{"email":"admin@example.com","password":"correct-horse"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "wrong password",
+			payload:    `{"email":"admin@example.com","password":"wrong"}`,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+
+			s.Mux.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
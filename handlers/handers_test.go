@@ -4,115 +4,119 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/go-fuego/fuego"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 
 	"gorm/handlers"
 	"gorm/models"
+	"gorm/queries"
+	"gorm/queries/mocks"
 )
 
-// MockUserQueries implements UserQueryInterface for testing
-type MockUserQueries struct {
-	users        map[uint]*models.User
-	returnErr    error
-	existingUser *models.User
-}
-
-func (m *MockUserQueries) GetUsers() ([]models.User, error) {
-	if m.returnErr != nil {
-		return nil, m.returnErr
-	}
-	var users []models.User
-	for _, u := range m.users {
-		users = append(users, *u)
-	}
-	return users, nil
-}
-
-func (m *MockUserQueries) GetUserByID(id uint) (*models.User, error) {
-	if m.returnErr != nil {
-		return nil, m.returnErr
-	}
-	user, exists := m.users[id]
-	if !exists {
-		return nil, gorm.ErrRecordNotFound
-	}
-	return user, nil
-}
+// fakeUsersPage reproduces the filter/sort/cursor semantics of
+// queries.UserQueries.GetUsersPage over an in-memory user set, so tests can
+// wire it up as a mocks.UserQueries.GetUsersPage return function instead of
+// hand-computing the expected page for every case.
+func fakeUsersPage(users map[uint]*models.User) func(queries.PageOptions) queries.Page {
+	return func(opts queries.PageOptions) queries.Page {
+		q := strings.ToLower(opts.Query)
+		var list []models.User
+		for _, u := range users {
+			if q != "" && !strings.Contains(strings.ToLower(u.Name), q) && !strings.Contains(strings.ToLower(u.Email), q) {
+				continue
+			}
+			list = append(list, *u)
+		}
 
-func (m *MockUserQueries) GetUserByEmail(email string) (*models.User, error) {
-	if m.existingUser != nil {
-		return m.existingUser, nil
-	}
-	for _, u := range m.users {
-		if u.Email == email {
-			return u, nil
+		byName := opts.Sort == "name"
+		desc := opts.Order == "desc"
+		sort.Slice(list, func(i, j int) bool {
+			var less bool
+			if byName && list[i].Name == list[j].Name {
+				less = list[i].ID < list[j].ID
+			} else if byName {
+				less = list[i].Name < list[j].Name
+			} else if list[i].CreatedAt.Equal(list[j].CreatedAt) {
+				less = list[i].ID < list[j].ID
+			} else {
+				less = list[i].CreatedAt.Before(list[j].CreatedAt)
+			}
+			if desc {
+				return !less
+			}
+			return less
+		})
+
+		if opts.Cursor != nil {
+			start := len(list)
+			for i, u := range list {
+				var after, before bool
+				if byName {
+					after = u.Name > opts.Cursor.Name || (u.Name == opts.Cursor.Name && u.ID > opts.Cursor.ID)
+					before = u.Name < opts.Cursor.Name || (u.Name == opts.Cursor.Name && u.ID < opts.Cursor.ID)
+				} else {
+					after = u.CreatedAt.After(opts.Cursor.CreatedAt) ||
+						(u.CreatedAt.Equal(opts.Cursor.CreatedAt) && u.ID > opts.Cursor.ID)
+					before = u.CreatedAt.Before(opts.Cursor.CreatedAt) ||
+						(u.CreatedAt.Equal(opts.Cursor.CreatedAt) && u.ID < opts.Cursor.ID)
+				}
+				if (desc && before) || (!desc && after) {
+					start = i
+					break
+				}
+			}
+			list = list[start:]
 		}
-	}
-	return nil, gorm.ErrRecordNotFound
-}
 
-func (m *MockUserQueries) CreateUser(user *models.User) error {
-	if m.returnErr != nil {
-		return m.returnErr
-	}
-	if m.users == nil {
-		m.users = make(map[uint]*models.User)
-	}
-	user.ID = uint(len(m.users) + 1)
-	m.users[user.ID] = user
-	return nil
-}
+		limit := opts.Limit
+		if limit <= 0 {
+			limit = queries.DefaultPageLimit
+		}
 
-func (m *MockUserQueries) UpdateUser(user *models.User) error {
-	if m.returnErr != nil {
-		return m.returnErr
-	}
-	if _, exists := m.users[user.ID]; !exists {
-		return gorm.ErrRecordNotFound
+		page := queries.Page{HasMore: len(list) > limit}
+		if page.HasMore {
+			list = list[:limit]
+		}
+		page.Users = list
+		if page.HasMore && len(list) > 0 {
+			last := list[len(list)-1]
+			page.NextCursor = queries.EncodeCursor(queries.Cursor{ID: last.ID, CreatedAt: last.CreatedAt, Name: last.Name})
+		}
+		return page
 	}
-	m.users[user.ID] = user
-	return nil
 }
 
-func (m *MockUserQueries) DeleteUser(id uint) error {
-	if m.returnErr != nil {
-		return m.returnErr
-	}
-	if _, exists := m.users[id]; !exists {
-		return gorm.ErrRecordNotFound
-	}
-	delete(m.users, id)
-	return nil
+// onUsersPage wires mockUQ.GetUsersPage to fakeUsersPage(users) for any
+// PageOptions, so list-oriented tests don't have to hand-compute results.
+func onUsersPage(mockUQ *mocks.UserQueries, users map[uint]*models.User) {
+	mockUQ.On("GetUsersPage", mock.AnythingOfType("queries.PageOptions")).
+		Return(fakeUsersPage(users), func(queries.PageOptions) error { return nil })
 }
 
 func TestHandlers(t *testing.T) {
 	// Common setup
-	setupServer := func(mock *MockUserQueries) *fuego.Server {
+	setupServer := func(mockUQ *mocks.UserQueries) *fuego.Server {
 		h := &handlers.Handlers{
-			UserQueries: mock,
+			UserQueries: mockUQ,
 		}
 
 		s := fuego.NewServer(
 			fuego.WithoutStartupMessages(),
+			fuego.WithEngineOptions(fuego.WithErrorHandler(handlers.ErrorHandler)),
 		)
 
 		fuego.Get(s, "/users", h.GetUsers)
 		fuego.Get(s, "/users/{id}", h.GetUserByID)
-		fuego.Post(s, "/users", h.CreateUser)
+		fuego.Post(s, "/users", h.CreateUser, fuego.OptionDefaultStatusCode(http.StatusCreated))
 		fuego.Put(s, "/users/{id}", h.UpdateUser)
 		fuego.Delete(s, "/users/{id}", h.DeleteUser)
 
-		// s.Get("/users", fuego.Handler(h.GetUsers))
-		// s.Get("/users/{id}", fuego.Handler(h.GetUserByID))
-		// s.Post("/users", fuego.Handler(h.CreateUser))
-		// s.Put("/users/{id}", fuego.Handler(h.UpdateUser))
-		// s.Delete("/users/{id}", fuego.Handler(h.DeleteUser))
-
 		return s
 	}
 
@@ -120,7 +124,7 @@ func TestHandlers(t *testing.T) {
 		tests := []struct {
 			name         string
 			userID       string
-			mockSetup    func(*MockUserQueries)
+			mockSetup    func(*mocks.UserQueries)
 			wantStatus   int
 			wantContains string
 		}{
@@ -133,14 +137,8 @@ func TestHandlers(t *testing.T) {
 			{
 				name:   "non-existent user",
 				userID: "999",
-				mockSetup: func(m *MockUserQueries) {
-					m.users = map[uint]*models.User{
-						1: {
-							Model: gorm.Model{ID: 1},
-							Name:  "Test User",
-							Email: "test@example.com",
-						},
-					}
+				mockSetup: func(m *mocks.UserQueries) {
+					m.On("GetUserByID", uint(999)).Return(nil, gorm.ErrRecordNotFound)
 				},
 				wantStatus:   http.StatusNotFound,
 				wantContains: "User not found",
@@ -148,12 +146,12 @@ func TestHandlers(t *testing.T) {
 			{
 				name:   "valid user",
 				userID: "1",
-				mockSetup: func(m *MockUserQueries) {
-					m.users = map[uint]*models.User{
-						1: {Model: gorm.Model{ID: 1},
-							Name:  "Test User",
-							Email: "test@example.com"},
-					}
+				mockSetup: func(m *mocks.UserQueries) {
+					m.On("GetUserByID", uint(1)).Return(&models.User{
+						Model: gorm.Model{ID: 1},
+						Name:  "Test User",
+						Email: "test@example.com",
+					}, nil)
 				},
 				wantStatus:   http.StatusOK,
 				wantContains: `"id":1`,
@@ -162,11 +160,11 @@ func TestHandlers(t *testing.T) {
 
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				mock := &MockUserQueries{}
+				mockUQ := mocks.NewUserQueries(t)
 				if tt.mockSetup != nil {
-					tt.mockSetup(mock)
+					tt.mockSetup(mockUQ)
 				}
-				s := setupServer(mock)
+				s := setupServer(mockUQ)
 
 				w := httptest.NewRecorder()
 				req := httptest.NewRequest(http.MethodGet, "/users/"+tt.userID, nil)
@@ -183,27 +181,48 @@ func TestHandlers(t *testing.T) {
 		tests := []struct {
 			name         string
 			payload      string
-			mockSetup    func(*MockUserQueries)
+			mockSetup    func(*mocks.UserQueries)
 			wantStatus   int
 			wantContains string
 		}{
 			{
-				name:         "valid input",
-				payload:      `{"name":"Alice","email":"alice@example.com"}`,
+				name:    "valid input",
+				payload: `{"name":"Alice","email":"alice@example.com"}`,
+				mockSetup: func(m *mocks.UserQueries) {
+					m.On("GetUserByEmail", "alice@example.com").Return(nil, gorm.ErrRecordNotFound)
+					m.On("CreateUser", mock.AnythingOfType("*models.User")).
+						Run(func(args mock.Arguments) {
+							args.Get(0).(*models.User).ID = 1
+						}).
+						Return(nil)
+				},
 				wantStatus:   http.StatusCreated,
 				wantContains: `"id":1`,
 			},
 			{
 				name:         "missing fields",
 				payload:      `{"name":""}`,
-				wantStatus:   http.StatusBadRequest,
-				wantContains: "Missing Required Fields",
+				wantStatus:   http.StatusUnprocessableEntity,
+				wantContains: `"field":"name"`,
+			},
+			{
+				name:         "malformed email",
+				payload:      `{"name":"Bob","email":"not-an-email"}`,
+				wantStatus:   http.StatusUnprocessableEntity,
+				wantContains: `"tag":"email"`,
+			},
+			{
+				name:         "name over max length",
+				payload:      `{"name":"` + strings.Repeat("a", 101) + `","email":"bob@example.com"}`,
+				wantStatus:   http.StatusUnprocessableEntity,
+				wantContains: `"tag":"max"`,
 			},
 			{
 				name:    "duplicate email",
 				payload: `{"name":"Bob","email":"exists@example.com"}`,
-				mockSetup: func(m *MockUserQueries) {
-					m.existingUser = &models.User{Email: "exists@example.com"}
+				mockSetup: func(m *mocks.UserQueries) {
+					m.On("GetUserByEmail", "exists@example.com").
+						Return(&models.User{Email: "exists@example.com"}, nil)
 				},
 				wantStatus:   http.StatusConflict,
 				wantContains: "already exists",
@@ -212,11 +231,11 @@ func TestHandlers(t *testing.T) {
 
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				mock := &MockUserQueries{}
+				mockUQ := mocks.NewUserQueries(t)
 				if tt.mockSetup != nil {
-					tt.mockSetup(mock)
+					tt.mockSetup(mockUQ)
 				}
-				s := setupServer(mock)
+				s := setupServer(mockUQ)
 
 				w := httptest.NewRecorder()
 				req := httptest.NewRequest(
@@ -235,17 +254,19 @@ func TestHandlers(t *testing.T) {
 	})
 
 	t.Run("UpdateUser", func(t *testing.T) {
-		existingUser := &models.User{
-			Model: gorm.Model{ID: 1},
-			Name:  "Test User",
-			Email: "test@example.com",
+		existingUser := func() *models.User {
+			return &models.User{
+				Model: gorm.Model{ID: 1},
+				Name:  "Test User",
+				Email: "test@example.com",
+			}
 		}
 
 		tests := []struct {
 			name         string
 			userID       string
 			payload      string
-			mockSetup    func(*MockUserQueries)
+			mockSetup    func(*mocks.UserQueries)
 			wantStatus   int
 			wantContains string
 		}{
@@ -253,8 +274,9 @@ func TestHandlers(t *testing.T) {
 				name:    "successful update",
 				userID:  "1",
 				payload: `{"name":"New Name","email":"new@example.com"}`,
-				mockSetup: func(m *MockUserQueries) {
-					m.users = map[uint]*models.User{1: existingUser}
+				mockSetup: func(m *mocks.UserQueries) {
+					m.On("GetUserByID", uint(1)).Return(existingUser(), nil)
+					m.On("UpdateUser", mock.AnythingOfType("*models.User")).Return(nil)
 				},
 				wantStatus:   http.StatusOK,
 				wantContains: `"name":"New Name"`,
@@ -270,8 +292,8 @@ func TestHandlers(t *testing.T) {
 				name:    "non-existent user",
 				userID:  "999",
 				payload: `{"name":"New Name"}`,
-				mockSetup: func(m *MockUserQueries) {
-					m.users = map[uint]*models.User{1: existingUser}
+				mockSetup: func(m *mocks.UserQueries) {
+					m.On("GetUserByID", uint(999)).Return(nil, gorm.ErrRecordNotFound)
 				},
 				wantStatus:   http.StatusNotFound,
 				wantContains: "not found",
@@ -280,11 +302,11 @@ func TestHandlers(t *testing.T) {
 
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				mock := &MockUserQueries{}
+				mockUQ := mocks.NewUserQueries(t)
 				if tt.mockSetup != nil {
-					tt.mockSetup(mock)
+					tt.mockSetup(mockUQ)
 				}
-				s := setupServer(mock)
+				s := setupServer(mockUQ)
 
 				w := httptest.NewRecorder()
 				req := httptest.NewRequest(
@@ -303,32 +325,25 @@ func TestHandlers(t *testing.T) {
 	})
 
 	t.Run("DeleteUser", func(t *testing.T) {
-		existingUser := &models.User{
-			Model: gorm.Model{ID: 1},
-			Name:  "Test User",
-			Email: "test@example.com",
-		}
-
 		tests := []struct {
-			name         string
-			userID       string
-			mockSetup    func(*MockUserQueries)
-			wantStatus   int
-			wantContains string
+			name       string
+			userID     string
+			mockSetup  func(*mocks.UserQueries)
+			wantStatus int
 		}{
 			{
 				name:   "successful delete",
 				userID: "1",
-				mockSetup: func(m *MockUserQueries) {
-					m.users = map[uint]*models.User{1: existingUser}
+				mockSetup: func(m *mocks.UserQueries) {
+					m.On("DeleteUser", uint(1)).Return(nil)
 				},
 				wantStatus: http.StatusOK,
 			},
 			{
 				name:   "non-existent user",
 				userID: "999",
-				mockSetup: func(m *MockUserQueries) {
-					m.users = map[uint]*models.User{1: existingUser}
+				mockSetup: func(m *mocks.UserQueries) {
+					m.On("DeleteUser", uint(999)).Return(gorm.ErrRecordNotFound)
 				},
 				wantStatus: http.StatusNotFound,
 			},
@@ -336,11 +351,11 @@ func TestHandlers(t *testing.T) {
 
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				mock := &MockUserQueries{}
+				mockUQ := mocks.NewUserQueries(t)
 				if tt.mockSetup != nil {
-					tt.mockSetup(mock)
+					tt.mockSetup(mockUQ)
 				}
-				s := setupServer(mock)
+				s := setupServer(mockUQ)
 
 				w := httptest.NewRecorder()
 				req := httptest.NewRequest(http.MethodDelete, "/users/"+tt.userID, nil)
@@ -355,37 +370,38 @@ func TestHandlers(t *testing.T) {
 	t.Run("GetUsers", func(t *testing.T) {
 		tests := []struct {
 			name         string
-			mockSetup    func(*MockUserQueries)
+			mockSetup    func(*mocks.UserQueries)
 			wantStatus   int
 			wantContains string
 		}{
 			{
 				name: "empty list",
-				mockSetup: func(m *MockUserQueries) {
-					m.users = make(map[uint]*models.User)
+				mockSetup: func(m *mocks.UserQueries) {
+					onUsersPage(m, map[uint]*models.User{})
 				},
 				wantStatus:   http.StatusOK,
-				wantContains: "[]",
+				wantContains: `"data":[]`,
 			},
 			{
 				name: "with users",
-				mockSetup: func(m *MockUserQueries) {
-					m.users = map[uint]*models.User{
+				mockSetup: func(m *mocks.UserQueries) {
+					onUsersPage(m, map[uint]*models.User{
 						1: {Model: gorm.Model{ID: 1},
 							Name:  "Test User",
 							Email: "test@example.com"},
-						2: {Model: gorm.Model{ID: 1},
+						2: {Model: gorm.Model{ID: 2},
 							Name:  "Test User",
 							Email: "test@example.com"},
-					}
+					})
 				},
 				wantStatus:   http.StatusOK,
 				wantContains: `"id":1`,
 			},
 			{
 				name: "database error",
-				mockSetup: func(m *MockUserQueries) {
-					m.returnErr = errors.New("database failure")
+				mockSetup: func(m *mocks.UserQueries) {
+					m.On("GetUsersPage", mock.AnythingOfType("queries.PageOptions")).
+						Return(queries.Page{}, errors.New("database failure"))
 				},
 				wantStatus: http.StatusInternalServerError,
 			},
@@ -393,11 +409,11 @@ func TestHandlers(t *testing.T) {
 
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
-				mock := &MockUserQueries{}
+				mockUQ := mocks.NewUserQueries(t)
 				if tt.mockSetup != nil {
-					tt.mockSetup(mock)
+					tt.mockSetup(mockUQ)
 				}
-				s := setupServer(mock)
+				s := setupServer(mockUQ)
 
 				w := httptest.NewRecorder()
 				req := httptest.NewRequest(http.MethodGet, "/users", nil)
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/go-fuego/fuego"
+	"gorm.io/gorm"
+
+	"gorm/auth"
+	"gorm/models"
+	"gorm/queries"
+)
+
+type Handlers struct {
+	UserQueries queries.UserQueryInterface
+}
+
+// DeleteResponse is the body returned by a successful DeleteUser call.
+type DeleteResponse struct {
+	Message string `json:"message"`
+}
+
+// CreateUserRequest is the body fuego binds for POST /users. It deliberately
+// carries no `validate` tags: fuego runs struct-tag validation on whatever
+// type ContextWithBody is parameterized with as soon as the body is
+// deserialized, before our handler ever sees it, which would bypass
+// validateStruct and its 422 ValidationError response. models.User (which
+// does carry the tags) is only populated afterwards, so validateStruct
+// remains the single source of validation errors.
+type CreateUserRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Role     string `json:"role,omitempty"`
+}
+
+// UpdateUserRequest is the body fuego binds for PUT /users/{id}. See
+// CreateUserRequest for why it has no `validate` tags.
+type UpdateUserRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func (h *Handlers) GetUserByID(c fuego.ContextNoBody) (models.User, error) {
+	id, err := parseID(c.PathParam("id"))
+	if err != nil {
+		return models.User{}, err
+	}
+
+	user, err := h.UserQueries.GetUserByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.User{}, fuego.NotFoundError{Err: errors.New("User not found"), Detail: "User not found"}
+		}
+		return models.User{}, fuego.InternalServerError{Err: err, Detail: "unexpected server error"}
+	}
+	return *user, nil
+}
+
+func (h *Handlers) CreateUser(c fuego.ContextWithBody[CreateUserRequest]) (models.User, error) {
+	body, err := c.Body()
+	if err != nil {
+		return models.User{}, fuego.BadRequestError{Err: err, Detail: err.Error()}
+	}
+
+	user := models.User{Name: body.Name, Email: body.Email, Password: body.Password, Role: body.Role}
+	if verr := validateStruct(user); verr != nil {
+		return models.User{}, verr
+	}
+
+	if existing, err := h.UserQueries.GetUserByEmail(user.Email); err == nil && existing != nil {
+		detail := fmt.Sprintf("user with email %s already exists", user.Email)
+		return models.User{}, fuego.ConflictError{Err: errors.New(detail), Detail: detail}
+	}
+
+	hash, err := auth.HashPassword(user.Password)
+	if err != nil {
+		return models.User{}, fuego.InternalServerError{Err: err, Detail: "unexpected server error"}
+	}
+	user.PasswordHash = hash
+	if user.Role == "" {
+		user.Role = models.RoleUser
+	}
+
+	if err := h.UserQueries.CreateUser(&user); err != nil {
+		return models.User{}, fuego.InternalServerError{Err: err, Detail: "unexpected server error"}
+	}
+	return user, nil
+}
+
+func (h *Handlers) UpdateUser(c fuego.ContextWithBody[UpdateUserRequest]) (models.User, error) {
+	id, err := parseID(c.PathParam("id"))
+	if err != nil {
+		return models.User{}, err
+	}
+
+	body, err := c.Body()
+	if err != nil {
+		return models.User{}, fuego.BadRequestError{Err: err, Detail: err.Error()}
+	}
+
+	existing, err := h.UserQueries.GetUserByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.User{}, fuego.NotFoundError{Err: errors.New("user not found"), Detail: "user not found"}
+		}
+		return models.User{}, fuego.InternalServerError{Err: err, Detail: "unexpected server error"}
+	}
+
+	if verr := validateStruct(models.User{Name: body.Name, Email: body.Email}); verr != nil {
+		return models.User{}, verr
+	}
+
+	existing.Name = body.Name
+	existing.Email = body.Email
+
+	if err := h.UserQueries.UpdateUser(existing); err != nil {
+		return models.User{}, fuego.InternalServerError{Err: err, Detail: "unexpected server error"}
+	}
+	return *existing, nil
+}
+
+func (h *Handlers) DeleteUser(c fuego.ContextNoBody) (DeleteResponse, error) {
+	id, err := parseID(c.PathParam("id"))
+	if err != nil {
+		return DeleteResponse{}, err
+	}
+
+	if err := h.UserQueries.DeleteUser(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return DeleteResponse{}, fuego.NotFoundError{Err: errors.New("user not found"), Detail: "user not found"}
+		}
+		return DeleteResponse{}, fuego.InternalServerError{Err: err, Detail: "unexpected server error"}
+	}
+	return DeleteResponse{Message: "user deleted"}, nil
+}
+
+func parseID(raw string) (uint, error) {
+	id, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, fuego.BadRequestError{Err: errors.New("Invalid ID"), Detail: "Invalid ID"}
+	}
+	return uint(id), nil
+}
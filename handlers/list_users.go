@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/go-fuego/fuego"
+
+	"gorm/models"
+	"gorm/queries"
+)
+
+// UsersPage is the response body for GET /users.
+type UsersPage struct {
+	Data       []models.User `json:"data"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+	HasMore    bool          `json:"has_more"`
+}
+
+// GetUsers lists users with cursor-based pagination. Supported query
+// parameters: limit, cursor, sort (name|created_at), order (asc|desc) and q
+// (case-insensitive substring match on name/email).
+func (h *Handlers) GetUsers(c fuego.ContextNoBody) (UsersPage, error) {
+	opts := queries.PageOptions{
+		Limit: parseLimit(c.QueryParam("limit")),
+		Sort:  parseSort(c.QueryParam("sort")),
+		Order: parseOrder(c.QueryParam("order")),
+		Query: c.QueryParam("q"),
+	}
+
+	if raw := c.QueryParam("cursor"); raw != "" {
+		cursor, err := queries.DecodeCursor(raw)
+		if err != nil {
+			return UsersPage{}, fuego.BadRequestError{Err: errors.New("invalid cursor"), Detail: "invalid cursor"}
+		}
+		opts.Cursor = &cursor
+	}
+
+	page, err := h.UserQueries.GetUsersPage(opts)
+	if err != nil {
+		return UsersPage{}, fuego.InternalServerError{Err: err, Detail: "unexpected server error"}
+	}
+
+	data := page.Users
+	if data == nil {
+		data = []models.User{}
+	}
+
+	return UsersPage{Data: data, NextCursor: page.NextCursor, HasMore: page.HasMore}, nil
+}
+
+func parseLimit(raw string) int {
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit <= 0 {
+		return queries.DefaultPageLimit
+	}
+	if limit > queries.MaxPageLimit {
+		return queries.MaxPageLimit
+	}
+	return limit
+}
+
+func parseSort(raw string) string {
+	if raw == "name" {
+		return "name"
+	}
+	return "created_at"
+}
+
+func parseOrder(raw string) string {
+	if raw == "desc" {
+		return "desc"
+	}
+	return "asc"
+}
@@ -0,0 +1,155 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"gorm/handlers"
+	"gorm/models"
+	"gorm/queries"
+	"gorm/queries/mocks"
+)
+
+func TestGetUsersPagination(t *testing.T) {
+	setupServer := func(mockUQ *mocks.UserQueries) *fuego.Server {
+		h := &handlers.Handlers{UserQueries: mockUQ}
+		s := fuego.NewServer(fuego.WithoutStartupMessages())
+		fuego.Get(s, "/users", h.GetUsers)
+		return s
+	}
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newMock := func(t *testing.T) *mocks.UserQueries {
+		m := mocks.NewUserQueries(t)
+		onUsersPage(m, map[uint]*models.User{
+			1: {Model: gorm.Model{ID: 1, CreatedAt: base}, Name: "Alice", Email: "alice@example.com"},
+			2: {Model: gorm.Model{ID: 2, CreatedAt: base.Add(time.Minute)}, Name: "Bob", Email: "bob@example.com"},
+			3: {Model: gorm.Model{ID: 3, CreatedAt: base.Add(2 * time.Minute)}, Name: "Carol", Email: "carol@example.com"},
+		})
+		return m
+	}
+
+	t.Run("malformed cursor returns 400", func(t *testing.T) {
+		// The handler rejects the cursor before ever reaching the query
+		// layer, so the mock expects no calls.
+		s := setupServer(mocks.NewUserQueries(t))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/users?cursor=not-base64!!", nil)
+		s.Mux.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("mid-stream cursor returns the next page", func(t *testing.T) {
+		s := setupServer(newMock(t))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/users?limit=1", nil)
+		s.Mux.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var first handlers.UsersPage
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &first))
+		require.Len(t, first.Data, 1)
+		require.Equal(t, uint(1), first.Data[0].ID)
+		require.True(t, first.HasMore)
+		require.NotEmpty(t, first.NextCursor)
+
+		w2 := httptest.NewRecorder()
+		req2 := httptest.NewRequest(http.MethodGet, "/users?limit=1&cursor="+first.NextCursor, nil)
+		s.Mux.ServeHTTP(w2, req2)
+		require.Equal(t, http.StatusOK, w2.Code)
+
+		var second handlers.UsersPage
+		require.NoError(t, json.Unmarshal(w2.Body.Bytes(), &second))
+		require.Len(t, second.Data, 1)
+		require.Equal(t, uint(2), second.Data[0].ID)
+		require.True(t, second.HasMore)
+	})
+
+	t.Run("last page reports has_more false", func(t *testing.T) {
+		s := setupServer(newMock(t))
+		cursor := queries.EncodeCursor(queries.Cursor{ID: 2, CreatedAt: base.Add(time.Minute)})
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/users?limit=1&cursor="+cursor, nil)
+		s.Mux.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var page handlers.UsersPage
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+		require.Len(t, page.Data, 1)
+		require.Equal(t, uint(3), page.Data[0].ID)
+		require.False(t, page.HasMore)
+		require.Empty(t, page.NextCursor)
+	})
+
+	t.Run("sort=name paginates by name instead of created_at", func(t *testing.T) {
+		// Names are deliberately out of creation order: the oldest user
+		// ("Carol") sorts last by name, so a cursor built from created_at
+		// would wrongly re-include or skip rows here.
+		m := mocks.NewUserQueries(t)
+		onUsersPage(m, map[uint]*models.User{
+			1: {Model: gorm.Model{ID: 1, CreatedAt: base}, Name: "Carol", Email: "carol@example.com"},
+			2: {Model: gorm.Model{ID: 2, CreatedAt: base.Add(time.Minute)}, Name: "Alice", Email: "alice@example.com"},
+			3: {Model: gorm.Model{ID: 3, CreatedAt: base.Add(2 * time.Minute)}, Name: "Bob", Email: "bob@example.com"},
+		})
+		s := setupServer(m)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/users?sort=name&limit=1", nil)
+		s.Mux.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var first handlers.UsersPage
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &first))
+		require.Len(t, first.Data, 1)
+		require.Equal(t, "Alice", first.Data[0].Name)
+		require.True(t, first.HasMore)
+		require.NotEmpty(t, first.NextCursor)
+
+		w2 := httptest.NewRecorder()
+		req2 := httptest.NewRequest(http.MethodGet, "/users?sort=name&limit=1&cursor="+first.NextCursor, nil)
+		s.Mux.ServeHTTP(w2, req2)
+		require.Equal(t, http.StatusOK, w2.Code)
+
+		var second handlers.UsersPage
+		require.NoError(t, json.Unmarshal(w2.Body.Bytes(), &second))
+		require.Len(t, second.Data, 1)
+		require.Equal(t, "Bob", second.Data[0].Name)
+		require.True(t, second.HasMore)
+
+		w3 := httptest.NewRecorder()
+		req3 := httptest.NewRequest(http.MethodGet, "/users?sort=name&limit=1&cursor="+second.NextCursor, nil)
+		s.Mux.ServeHTTP(w3, req3)
+		require.Equal(t, http.StatusOK, w3.Code)
+
+		var third handlers.UsersPage
+		require.NoError(t, json.Unmarshal(w3.Body.Bytes(), &third))
+		require.Len(t, third.Data, 1)
+		require.Equal(t, "Carol", third.Data[0].Name)
+		require.False(t, third.HasMore)
+	})
+
+	t.Run("q filters by name or email", func(t *testing.T) {
+		s := setupServer(newMock(t))
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/users?q=carol", nil)
+		s.Mux.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var page handlers.UsersPage
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+		require.Len(t, page.Data, 1)
+		require.Equal(t, "Carol", page.Data[0].Name)
+	})
+}
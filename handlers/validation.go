@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-fuego/fuego"
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = validator.New()
+
+// FieldError describes a single struct-tag validation failure.
+type FieldError struct {
+	Field   string `json:"field"`
+	Tag     string `json:"tag"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned (as HTTP 422) when a request body fails
+// struct-tag validation. It marshals as a bare JSON array of FieldError so
+// clients can iterate failures directly.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) StatusCode() int {
+	return http.StatusUnprocessableEntity
+}
+
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Fields)
+}
+
+// ErrorHandler is a fuego.WithErrorHandler override that must be installed
+// on every server built from this package's handlers. fuego's default
+// HandleHTTPError coerces any ErrorWithStatus into a generic fuego.HTTPError
+// before serializing it, discarding ValidationError's custom MarshalJSON
+// (the bare field-error array) along the way. Passing *ValidationError
+// through unchanged lets that MarshalJSON reach the client instead.
+func ErrorHandler(ctx context.Context, err error) error {
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		return verr
+	}
+	return fuego.ErrorHandler(ctx, err)
+}
+
+// validateStruct runs struct-tag validation (see the `validate` tags on
+// models.User) and translates any failures into a *ValidationError.
+func validateStruct(v any) error {
+	err := validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return fuego.BadRequestError{Err: err, Detail: err.Error()}
+	}
+
+	fields := make([]FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		fields = append(fields, FieldError{
+			Field:   strings.ToLower(fe.Field()),
+			Tag:     fe.Tag(),
+			Message: fieldErrorMessage(fe),
+		})
+	}
+	return &ValidationError{Fields: fields}
+}
+
+func fieldErrorMessage(fe validator.FieldError) string {
+	field := strings.ToLower(fe.Field())
+	switch fe.Tag() {
+	case "required":
+		return fmt.Sprintf("%s is required", field)
+	case "email":
+		return fmt.Sprintf("%s must be a valid email address", field)
+	case "min":
+		return fmt.Sprintf("%s must be at least %s characters", field, fe.Param())
+	case "max":
+		return fmt.Sprintf("%s must be at most %s characters", field, fe.Param())
+	default:
+		return fmt.Sprintf("%s is invalid", field)
+	}
+}
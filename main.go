@@ -1,38 +1,192 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"time"
+
+	"gorm/auth"
+	"gorm/docs"
 	"gorm/handlers"
+	"gorm/migrations"
 	"gorm/models"
 	"gorm/queries"
+	"gorm/storage"
 
 	"github.com/go-fuego/fuego"
-
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
 )
 
 func main() {
-	db, err := gorm.Open(sqlite.Open("users.db"), &gorm.Config{})
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrateCLI(os.Args[2:])
+		return
+	}
+
+	runServer()
+}
+
+// runMigrateCLI implements the `migrate up|down|version` subcommands on top
+// of golang-migrate, using the same DATABASE_URL/DB_DRIVER+DB_DSN
+// resolution as the server.
+func runMigrateCLI(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "usage: gorm migrate <up|down|version>")
+		os.Exit(1)
+	}
+
+	driver, dsn, err := storage.MigrateDSN()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "migrate:", err)
+		os.Exit(1)
+	}
+
+	switch args[0] {
+	case "up":
+		err = migrations.Up(driver, dsn)
+	case "down":
+		err = migrations.Down(driver, dsn)
+	case "version":
+		var version uint
+		var dirty bool
+		version, dirty, err = migrations.Version(driver, dsn)
+		if err == nil {
+			fmt.Printf("version %d (dirty=%v)\n", version, dirty)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "unknown migrate subcommand %q\n", args[0])
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate %s: %v\n", args[0], err)
+		os.Exit(1)
+	}
+}
+
+func runServer() {
+	driver, migrateDSN, err := storage.MigrateDSN()
+	if err != nil {
+		panic("error resolving database DSN: " + err.Error())
+	}
+	if err := migrations.Up(driver, migrateDSN); err != nil {
+		panic("error applying migrations: " + err.Error())
+	}
+
+	db, err := storage.Open()
 	if err != nil {
 		panic("error connecting to  database")
 	}
 
-	db.AutoMigrate(&models.User{})
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		secret = "dev-secret-change-me"
+	}
+	issuer := auth.NewTokenIssuer(secret, 24*time.Hour)
+
+	server := newServer(&queries.UserQueries{DB: db}, issuer)
+	server.Run()
+}
+
+// newServer wires every route (and its OpenAPI documentation) onto a fresh
+// fuego server. It takes the user store and token issuer as parameters,
+// rather than building them itself, so tests can exercise the full route
+// table and generated openapi.json against a mocks.UserQueries.
+func newServer(userQueries queries.UserQueryInterface, issuer *auth.TokenIssuer) *fuego.Server {
+	server := fuego.NewServer(
+		// We serve our own embedded docs UI at /docs instead of fuego's
+		// default Swagger UI, which loads its assets from a CDN.
+		fuego.WithEngineOptions(
+			fuego.WithOpenAPIConfig(fuego.OpenAPIConfig{DisableSwaggerUI: true}),
+			fuego.WithErrorHandler(handlers.ErrorHandler),
+		),
+	)
 
-	server := fuego.NewServer()
+	h := &handlers.Handlers{UserQueries: userQueries}
 
-	userQueries := &queries.UserQueries{DB: db}
-	handlers := &handlers.Handlers{UserQueries: userQueries}
+	requireAuth := issuer.Middleware
+	requireAdmin := auth.RequireRole(models.RoleAdmin)
+	requireSelfOrAdmin := auth.RequireSelfOrAdmin
 
 	fuego.Get(server, "/", func(c fuego.ContextNoBody) (string, error) {
 		return "Hello, World!", nil
 	})
-	fuego.Get(server, "/users", handlers.GetUsers)
-	fuego.Post(server, "/users", handlers.CreateUser)
-	fuego.Get(server, "/users/{id}", handlers.GetUserByID)
-	fuego.Put(server, "/users/{id}", handlers.UpdateUser)
-	fuego.Delete(server, "/users/{id}", handlers.DeleteUser)
+	fuego.Post(server, "/login", auth.NewLoginHandler(issuer, userQueries),
+		fuego.OptionSummary("Log in"),
+		fuego.OptionDescription("Exchange an email/password pair for a JWT used as a Bearer token on every other route."),
+		fuego.OptionTags("auth"),
+		fuego.OptionAddError(http.StatusUnauthorized, "invalid email or password", fuego.UnauthorizedError{Err: errors.New("invalid email or password")}),
+	)
 
-	server.Run()
+	fuego.Get(server, "/users", h.GetUsers,
+		fuego.OptionMiddleware(requireAuth, requireAdmin),
+		fuego.OptionSummary("List users"),
+		fuego.OptionDescription("Cursor-paginated user listing. Supports limit, cursor, sort, order and q (case-insensitive substring match on name/email). Admin only."),
+		fuego.OptionTags("users"),
+		fuego.OptionAddError(http.StatusBadRequest, "malformed cursor", fuego.BadRequestError{Err: errors.New("invalid cursor")}),
+		fuego.OptionAddError(http.StatusInternalServerError, "unexpected server error", fuego.InternalServerError{Err: errors.New("database failure")}),
+	)
+	fuego.Post(server, "/users", h.CreateUser,
+		fuego.OptionDefaultStatusCode(http.StatusCreated),
+		fuego.OptionSummary("Create a user"),
+		fuego.OptionDescription("Creates a user after struct-tag validation and an email-uniqueness check. The password is hashed before storage and never echoed back."),
+		fuego.OptionTags("users"),
+		fuego.OptionAddError(http.StatusUnprocessableEntity, "request body failed validation", &handlers.ValidationError{Fields: []handlers.FieldError{
+			{Field: "email", Tag: "email", Message: "email must be a valid email address"},
+		}}),
+		fuego.OptionAddError(http.StatusConflict, "a user with this email already exists", fuego.ConflictError{Err: errors.New("user with email alice@example.com already exists")}),
+		fuego.OptionAddError(http.StatusInternalServerError, "unexpected server error", fuego.InternalServerError{Err: errors.New("database failure")}),
+	)
+	fuego.Get(server, "/users/{id}", h.GetUserByID,
+		fuego.OptionMiddleware(requireAuth, requireSelfOrAdmin),
+		fuego.OptionSummary("Get a user"),
+		fuego.OptionDescription("Returns a single user by ID. Callers may read their own record; admins may read any record."),
+		fuego.OptionTags("users"),
+		fuego.OptionAddError(http.StatusBadRequest, "id is not a valid integer", fuego.BadRequestError{Err: errors.New("Invalid ID")}),
+		fuego.OptionAddError(http.StatusNotFound, "no user with that ID", fuego.NotFoundError{Err: errors.New("User not found")}),
+	)
+	fuego.Put(server, "/users/{id}", h.UpdateUser,
+		fuego.OptionMiddleware(requireAuth, requireSelfOrAdmin),
+		fuego.OptionSummary("Update a user"),
+		fuego.OptionDescription("Updates a user's name and email after struct-tag validation. Callers may update their own record; admins may update any record."),
+		fuego.OptionTags("users"),
+		fuego.OptionAddError(http.StatusBadRequest, "id is not a valid integer", fuego.BadRequestError{Err: errors.New("Invalid ID")}),
+		fuego.OptionAddError(http.StatusNotFound, "no user with that ID", fuego.NotFoundError{Err: errors.New("user not found")}),
+		fuego.OptionAddError(http.StatusUnprocessableEntity, "request body failed validation", &handlers.ValidationError{Fields: []handlers.FieldError{
+			{Field: "email", Tag: "email", Message: "email must be a valid email address"},
+		}}),
+		fuego.OptionAddError(http.StatusInternalServerError, "unexpected server error", fuego.InternalServerError{Err: errors.New("database failure")}),
+	)
+	fuego.Delete(server, "/users/{id}", h.DeleteUser,
+		fuego.OptionMiddleware(requireAuth, requireAdmin),
+		fuego.OptionSummary("Delete a user"),
+		fuego.OptionDescription("Deletes a user by ID. Admin only."),
+		fuego.OptionTags("users"),
+		fuego.OptionAddError(http.StatusBadRequest, "id is not a valid integer", fuego.BadRequestError{Err: errors.New("Invalid ID")}),
+		fuego.OptionAddError(http.StatusNotFound, "no user with that ID", fuego.NotFoundError{Err: errors.New("user not found")}),
+		fuego.OptionAddError(http.StatusInternalServerError, "unexpected server error", fuego.InternalServerError{Err: errors.New("database failure")}),
+	)
+
+	mountDocs(server)
+
+	return server
+}
 
+// mountDocs exposes the generated OpenAPI document at /openapi.json and a
+// small embedded API explorer (docs.FS) at /docs, so the API is
+// self-documenting without depending on any externally hosted UI.
+func mountDocs(server *fuego.Server) {
+	static, err := fs.Sub(docs.FS, "static")
+	if err != nil {
+		panic("docs: embedded static assets missing: " + err.Error())
+	}
+
+	server.Mux.Handle("GET /docs/", http.StripPrefix("/docs/", http.FileServer(http.FS(static))))
+	server.Mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(server.OpenAPI.Description())
+	})
 }
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"gorm/auth"
+	"gorm/queries/mocks"
+)
+
+// openAPISpec is the subset of the generated document this test cares
+// about: which paths/operations exist and which response codes they
+// document.
+type openAPISpec struct {
+	Paths map[string]map[string]struct {
+		Responses map[string]struct {
+			Description string `json:"description"`
+		} `json:"responses"`
+	} `json:"paths"`
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	issuer := auth.NewTokenIssuer("test-secret", time.Hour)
+	server := newServer(mocks.NewUserQueries(t), issuer)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/openapi.json", nil)
+	server.Mux.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var spec openAPISpec
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+
+	tests := []struct {
+		path      string
+		method    string
+		wantCodes []string
+	}{
+		{"/users", http.MethodGet, []string{"400", "500"}},
+		{"/users", http.MethodPost, []string{"409", "422", "500"}},
+		{"/users/{id}", http.MethodGet, []string{"400", "404"}},
+		{"/users/{id}", http.MethodPut, []string{"400", "404", "422", "500"}},
+		{"/users/{id}", http.MethodDelete, []string{"400", "404", "500"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			operations, ok := spec.Paths[tt.path]
+			require.Truef(t, ok, "path %s missing from openapi.json", tt.path)
+
+			op, ok := operations[strings.ToLower(tt.method)]
+			require.Truef(t, ok, "%s %s missing from openapi.json", tt.method, tt.path)
+
+			for _, code := range tt.wantCodes {
+				require.Containsf(t, op.Responses, code, "%s %s missing documented %s response", tt.method, tt.path, code)
+			}
+		})
+	}
+}
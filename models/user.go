@@ -0,0 +1,46 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Role is the set of permission levels a User can hold.
+const (
+	RoleAdmin = "admin"
+	RoleUser  = "user"
+)
+
+type User struct {
+	gorm.Model
+	Name         string `json:"name" validate:"required,min=2,max=100"`
+	Email        string `json:"email" gorm:"unique" validate:"required,email"`
+	PasswordHash string `json:"-"`
+	Role         string `json:"role" gorm:"default:user"`
+
+	// Password is only ever populated from an incoming create request; it is
+	// hashed into PasswordHash and never persisted or echoed back.
+	Password string `json:"password,omitempty" gorm:"-"`
+}
+
+// MarshalJSON keeps the wire format lowercase and hides fields (like
+// PasswordHash) that should never leave the server.
+func (u User) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ID        uint      `json:"id"`
+		Name      string    `json:"name"`
+		Email     string    `json:"email"`
+		Role      string    `json:"role"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}{
+		ID:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		Role:      u.Role,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	})
+}
@@ -0,0 +1,17 @@
+package queries
+
+//go:generate go run github.com/vektra/mockery/v2 --config=../.mockery.yaml
+
+import "gorm/models"
+
+// UserQueryInterface is the persistence contract handlers.Handlers depends
+// on. It is satisfied by *UserQueries in production and by
+// queries/mocks.UserQueries in tests.
+type UserQueryInterface interface {
+	GetUsersPage(opts PageOptions) (Page, error)
+	GetUserByID(id uint) (*models.User, error)
+	GetUserByEmail(email string) (*models.User, error)
+	CreateUser(user *models.User) error
+	UpdateUser(user *models.User) error
+	DeleteUser(id uint) error
+}
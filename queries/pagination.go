@@ -0,0 +1,126 @@
+package queries
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm/models"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// Cursor is the keyset position a page was read up to. It is handed back to
+// callers base64-encoded so it stays opaque on the wire. Name is always
+// populated but only consulted when paginating with sort=name; it rides
+// alongside CreatedAt rather than replacing it so a single Cursor type works
+// for either sort column.
+type Cursor struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name,omitempty"`
+}
+
+func EncodeCursor(c Cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(encoded string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("decode cursor: %w", err)
+	}
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return Cursor{}, fmt.Errorf("decode cursor: %w", err)
+	}
+	return c, nil
+}
+
+// PageOptions controls a single GetUsersPage call. Sort must be "name" or
+// "created_at" and Order must be "asc" or "desc"; callers are expected to
+// have already validated these against that whitelist.
+type PageOptions struct {
+	Limit  int
+	Cursor *Cursor
+	Sort   string
+	Order  string
+	Query  string
+}
+
+type Page struct {
+	Users      []models.User
+	NextCursor string
+	HasMore    bool
+}
+
+func (q *UserQueries) GetUsersPage(opts PageOptions) (Page, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultPageLimit
+	}
+	if limit > MaxPageLimit {
+		limit = MaxPageLimit
+	}
+
+	sortCol := "created_at"
+	if opts.Sort == "name" {
+		sortCol = "name"
+	}
+	order := "asc"
+	if opts.Order == "desc" {
+		order = "desc"
+	}
+
+	db := q.DB.Model(&models.User{})
+
+	if opts.Query != "" {
+		like := "%" + strings.ToLower(opts.Query) + "%"
+		db = db.Where("LOWER(name) LIKE ? OR LOWER(email) LIKE ?", like, like)
+	}
+
+	if opts.Cursor != nil {
+		cursorCol, cursorVal := "created_at", any(opts.Cursor.CreatedAt)
+		if sortCol == "name" {
+			cursorCol, cursorVal = "name", any(opts.Cursor.Name)
+		}
+		if order == "desc" {
+			db = db.Where(
+				fmt.Sprintf("%s < ? OR (%s = ? AND id < ?)", cursorCol, cursorCol),
+				cursorVal, cursorVal, opts.Cursor.ID,
+			)
+		} else {
+			db = db.Where(
+				fmt.Sprintf("%s > ? OR (%s = ? AND id > ?)", cursorCol, cursorCol),
+				cursorVal, cursorVal, opts.Cursor.ID,
+			)
+		}
+	}
+
+	// Fetch one extra row so we can tell whether another page follows
+	// without a separate COUNT query.
+	db = db.Order(fmt.Sprintf("%s %s, id %s", sortCol, order, order)).Limit(limit + 1)
+
+	var users []models.User
+	if err := db.Find(&users).Error; err != nil {
+		return Page{}, err
+	}
+
+	page := Page{HasMore: len(users) > limit}
+	if page.HasMore {
+		users = users[:limit]
+	}
+	page.Users = users
+
+	if page.HasMore && len(users) > 0 {
+		last := users[len(users)-1]
+		page.NextCursor = EncodeCursor(Cursor{ID: last.ID, CreatedAt: last.CreatedAt, Name: last.Name})
+	}
+	return page, nil
+}
@@ -0,0 +1,120 @@
+package queries_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"gorm/migrations"
+	"gorm/models"
+	"gorm/queries"
+)
+
+// newPaginationDB opens a fresh, migrated, empty sqlite file. seedUsers
+// fills it with users whose CreatedAt values are strictly increasing, so
+// created_at-ordered pagination has a deterministic order to assert against.
+func newPaginationDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := filepath.Join(t.TempDir(), "users.db")
+	require.NoError(t, migrations.Up("sqlite", "sqlite://"+dsn))
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	return db
+}
+
+func seedUsers(t *testing.T, db *gorm.DB, names ...string) []models.User {
+	t.Helper()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	users := make([]models.User, 0, len(names))
+	for i, name := range names {
+		u := models.User{
+			Name:  name,
+			Email: name + "@example.com",
+			Role:  models.RoleUser,
+		}
+		require.NoError(t, db.Create(&u).Error)
+		require.NoError(t, db.Model(&u).Update("created_at", base.Add(time.Duration(i)*time.Minute)).Error)
+		u.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		users = append(users, u)
+	}
+	return users
+}
+
+func TestGetUsersPageCreatedAtOrder(t *testing.T) {
+	db := newPaginationDB(t)
+	seedUsers(t, db, "Carol", "Alice", "Bob") // seeded in this order, so created_at order matches it
+	uq := &queries.UserQueries{DB: db}
+
+	page, err := uq.GetUsersPage(queries.PageOptions{Limit: 2, Sort: "created_at", Order: "asc"})
+	require.NoError(t, err)
+	require.True(t, page.HasMore)
+	require.Len(t, page.Users, 2)
+	require.Equal(t, []string{"Carol", "Alice"}, []string{page.Users[0].Name, page.Users[1].Name})
+	require.NotEmpty(t, page.NextCursor)
+
+	cursor, err := queries.DecodeCursor(page.NextCursor)
+	require.NoError(t, err)
+
+	next, err := uq.GetUsersPage(queries.PageOptions{Limit: 2, Sort: "created_at", Order: "asc", Cursor: &cursor})
+	require.NoError(t, err)
+	require.False(t, next.HasMore)
+	require.Equal(t, []string{"Bob"}, []string{next.Users[0].Name})
+}
+
+func TestGetUsersPageNameOrder(t *testing.T) {
+	db := newPaginationDB(t)
+	seedUsers(t, db, "Carol", "Alice", "Bob") // sort=name must ignore insertion/created_at order
+	uq := &queries.UserQueries{DB: db}
+
+	page, err := uq.GetUsersPage(queries.PageOptions{Limit: 2, Sort: "name", Order: "asc"})
+	require.NoError(t, err)
+	require.True(t, page.HasMore)
+	require.Equal(t, []string{"Alice", "Bob"}, []string{page.Users[0].Name, page.Users[1].Name})
+
+	cursor, err := queries.DecodeCursor(page.NextCursor)
+	require.NoError(t, err)
+	require.Equal(t, "Bob", cursor.Name)
+
+	next, err := uq.GetUsersPage(queries.PageOptions{Limit: 2, Sort: "name", Order: "asc", Cursor: &cursor})
+	require.NoError(t, err)
+	require.False(t, next.HasMore)
+	require.Equal(t, []string{"Carol"}, []string{next.Users[0].Name})
+}
+
+func TestGetUsersPageNameOrderDesc(t *testing.T) {
+	db := newPaginationDB(t)
+	seedUsers(t, db, "Carol", "Alice", "Bob")
+	uq := &queries.UserQueries{DB: db}
+
+	page, err := uq.GetUsersPage(queries.PageOptions{Limit: 10, Sort: "name", Order: "desc"})
+	require.NoError(t, err)
+	require.False(t, page.HasMore)
+	require.Equal(t, []string{"Carol", "Bob", "Alice"}, []string{page.Users[0].Name, page.Users[1].Name, page.Users[2].Name})
+}
+
+func TestGetUsersPageSearch(t *testing.T) {
+	db := newPaginationDB(t)
+	seedUsers(t, db, "Carol", "Alice", "Bob")
+	uq := &queries.UserQueries{DB: db}
+
+	page, err := uq.GetUsersPage(queries.PageOptions{Limit: 10, Sort: "name", Order: "asc", Query: "ali"})
+	require.NoError(t, err)
+	require.Len(t, page.Users, 1)
+	require.Equal(t, "Alice", page.Users[0].Name)
+}
+
+func TestGetUsersPageEmpty(t *testing.T) {
+	db := newPaginationDB(t)
+	uq := &queries.UserQueries{DB: db}
+
+	page, err := uq.GetUsersPage(queries.PageOptions{Limit: 10})
+	require.NoError(t, err)
+	require.False(t, page.HasMore)
+	require.Empty(t, page.Users)
+	require.Empty(t, page.NextCursor)
+}
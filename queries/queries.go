@@ -0,0 +1,50 @@
+package queries
+
+import (
+	"gorm/models"
+
+	"gorm.io/gorm"
+)
+
+// UserQueries is the gorm-backed implementation of the user persistence
+// layer used by handlers.Handlers.
+type UserQueries struct {
+	DB *gorm.DB
+}
+
+func (q *UserQueries) GetUserByID(id uint) (*models.User, error) {
+	var user models.User
+	if err := q.DB.First(&user, id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (q *UserQueries) GetUserByEmail(email string) (*models.User, error) {
+	var user models.User
+	if err := q.DB.Where("email = ?", email).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (q *UserQueries) CreateUser(user *models.User) error {
+	return q.DB.Create(user).Error
+}
+
+func (q *UserQueries) UpdateUser(user *models.User) error {
+	return q.DB.Save(user).Error
+}
+
+// DeleteUser soft-deletes the user with the given ID, returning
+// gorm.ErrRecordNotFound when no live user has that ID.
+func (q *UserQueries) DeleteUser(id uint) error {
+	result := q.DB.Delete(&models.User{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
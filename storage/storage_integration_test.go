@@ -0,0 +1,113 @@
+//go:build integration
+
+// Run with: go test -tags=integration ./storage/...
+// Requires a local Docker daemon for testcontainers-go to start Postgres.
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"gorm/migrations"
+	"gorm/models"
+	"gorm/queries"
+)
+
+func TestPostgresDriverIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "postgres:16-alpine",
+			ExposedPorts: []string{"5432/tcp"},
+			Env: map[string]string{
+				"POSTGRES_USER":     "gorm",
+				"POSTGRES_PASSWORD": "gorm",
+				"POSTGRES_DB":       "gorm_test",
+			},
+			WaitingFor: wait.ForListeningPort("5432/tcp"),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+	port, err := container.MappedPort(ctx, "5432")
+	require.NoError(t, err)
+
+	dsn := fmt.Sprintf("postgres://gorm:gorm@%s:%s/gorm_test?sslmode=disable", host, port.Port())
+	require.NoError(t, migrations.Up("postgres", dsn))
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	userQueries := &queries.UserQueries{DB: db}
+
+	user := &models.User{Name: "Ada", Email: "ada@example.com", Role: models.RoleUser}
+	require.NoError(t, userQueries.CreateUser(user))
+	require.NotZero(t, user.ID)
+
+	fetched, err := userQueries.GetUserByID(user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "ada@example.com", fetched.Email)
+
+	require.NoError(t, userQueries.DeleteUser(user.ID))
+	_, err = userQueries.GetUserByID(user.ID)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestMySQLDriverIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        "mysql:8",
+			ExposedPorts: []string{"3306/tcp"},
+			Env: map[string]string{
+				"MYSQL_ROOT_PASSWORD": "gorm",
+				"MYSQL_USER":          "gorm",
+				"MYSQL_PASSWORD":      "gorm",
+				"MYSQL_DATABASE":      "gorm_test",
+			},
+			WaitingFor: wait.ForLog("port: 3306  MySQL Community Server"),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	host, err := container.Host(ctx)
+	require.NoError(t, err)
+	port, err := container.MappedPort(ctx, "3306")
+	require.NoError(t, err)
+
+	gormDSN := fmt.Sprintf("gorm:gorm@tcp(%s:%s)/gorm_test?parseTime=true", host, port.Port())
+	require.NoError(t, migrations.Up("mysql", "mysql://"+gormDSN))
+
+	db, err := gorm.Open(mysql.Open(gormDSN), &gorm.Config{})
+	require.NoError(t, err)
+
+	userQueries := &queries.UserQueries{DB: db}
+
+	user := &models.User{Name: "Ada", Email: "ada@example.com", Role: models.RoleUser}
+	require.NoError(t, userQueries.CreateUser(user))
+	require.NotZero(t, user.ID)
+
+	fetched, err := userQueries.GetUserByID(user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "ada@example.com", fetched.Email)
+
+	require.NoError(t, userQueries.DeleteUser(user.ID))
+	_, err = userQueries.GetUserByID(user.ID)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
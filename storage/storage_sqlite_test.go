@@ -0,0 +1,39 @@
+package storage_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"gorm/migrations"
+	"gorm/models"
+	"gorm/queries"
+)
+
+// TestSQLiteDriverIntegration exercises the sqlite migration and
+// queries.UserQueries against a sqlite file end to end. Unlike the
+// Postgres/mysql tests it needs no Docker daemon, since sqlite is just a file.
+func TestSQLiteDriverIntegration(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "users.db")
+	require.NoError(t, migrations.Up("sqlite", "sqlite://"+dsn))
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	userQueries := &queries.UserQueries{DB: db}
+
+	user := &models.User{Name: "Ada", Email: "ada@example.com", Role: models.RoleUser}
+	require.NoError(t, userQueries.CreateUser(user))
+	require.NotZero(t, user.ID)
+
+	fetched, err := userQueries.GetUserByID(user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "ada@example.com", fetched.Email)
+
+	require.NoError(t, userQueries.DeleteUser(user.ID))
+	_, err = userQueries.GetUserByID(user.ID)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}